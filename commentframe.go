@@ -0,0 +1,19 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+// CommentFrame represents the ID3v2 COMM frame: a comment tagged with a
+// 3-letter ISO-639-2 language code and an optional short Description,
+// followed by the actual comment Text.
+type CommentFrame struct {
+	Encoding    byte
+	Language    string
+	Description string
+	Text        string
+}
+
+func (cf CommentFrame) Body() []byte {
+	return formLangDescText(cf.Encoding, cf.Language, cf.Description, cf.Text)
+}
@@ -0,0 +1,127 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"os"
+
+	"github.com/bogem/id3v2/id3v1"
+)
+
+// File is a façade over an on-disk MP3 file that may carry both an
+// ID3v1 tag (the last 128 bytes) and an ID3v2 tag (at the front of the
+// file). OpenFile reads whichever of the two are present; Save rewrites
+// both in a single pass, so the two tags never fall out of sync.
+type File struct {
+	// ContainsID3v1 reports whether the opened file carried an ID3v1 tag.
+	ContainsID3v1 bool
+
+	// ContainsID3v2 reports whether the opened file carried an ID3v2 tag.
+	ContainsID3v2 bool
+
+	// ID3v1Tag is never nil: if ContainsID3v1 is false at open time,
+	// OpenFile sets it to a new empty tag, which Save writes only if
+	// SyncID3v1FromID3v2 is set.
+	ID3v1Tag *id3v1.Tag
+
+	// ID3v2Tag is never nil. If ContainsID3v2 is false at open time, it
+	// is a tag with no frames.
+	ID3v2Tag *Tag
+
+	path string
+}
+
+// SaveOptions controls how File.Save reconciles the two tags.
+type SaveOptions struct {
+	// SyncID3v1FromID3v2, if true, overwrites Title/Artist/Album/Year/Genre
+	// of ID3v1Tag with the corresponding ID3v2Tag values before saving.
+	SyncID3v1FromID3v2 bool
+
+	// StripID3v1, if true, removes the ID3v1 tag entirely on save.
+	StripID3v1 bool
+}
+
+// OpenFile opens the file at path and parses its ID3v1 and ID3v2 tags,
+// if present. The returned File must be closed with Close.
+func OpenFile(path string) (*File, error) {
+	f := &File{path: path}
+
+	if rawFile, err := os.Open(path); err == nil {
+		v1Tag, err := id3v1.Parse(rawFile)
+		rawFile.Close()
+		if err == nil {
+			f.ContainsID3v1 = true
+			f.ID3v1Tag = v1Tag
+		}
+	}
+
+	v2Tag, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+	f.ContainsID3v2 = len(v2Tag.AllFrames()) > 0
+	f.ID3v2Tag = v2Tag
+
+	if !f.ContainsID3v1 {
+		f.ID3v1Tag = id3v1.NewTag()
+	}
+
+	return f, nil
+}
+
+// Save reconciles both tags and rewrites the whole file through a temp
+// file that is renamed over the original in a single step, so a crash
+// partway through never leaves one tag updated and the other stale.
+func (f *File) Save(opts SaveOptions) error {
+	if f.ID3v2Tag.readOnly {
+		return ErrReadOnly
+	}
+
+	if opts.SyncID3v1FromID3v2 {
+		f.ID3v1Tag.SetTitle(f.ID3v2Tag.Title())
+		f.ID3v1Tag.SetArtist(f.ID3v2Tag.Artist())
+		f.ID3v1Tag.SetAlbum(f.ID3v2Tag.Album())
+		f.ID3v1Tag.SetYear(f.ID3v2Tag.Year())
+		f.ID3v1Tag.SetGenre(f.ID3v2Tag.Genre())
+	}
+
+	v2 := f.ID3v2Tag
+	frames := v2.formAllFrames()
+
+	stat, err := v2.file.Stat()
+	if err != nil {
+		return err
+	}
+	musicSize := stat.Size() - v2.originalSize
+	if f.ContainsID3v1 {
+		musicSize -= 128
+	}
+
+	// A file with no ID3v1 tag stays that way unless the caller asks
+	// for one via SyncID3v1FromID3v2, or explicitly strips an existing
+	// one.
+	keepID3v1 := f.ContainsID3v1 || opts.SyncID3v1FromID3v2
+	if opts.StripID3v1 {
+		keepID3v1 = false
+	}
+	var trailer []byte
+	if keepID3v1 {
+		trailer = f.ID3v1Tag.Bytes()
+	}
+
+	if err := v2.rewriteThroughTempFile(frames, int64(v2.padding), musicSize, trailer); err != nil {
+		return err
+	}
+
+	f.ContainsID3v1 = keepID3v1
+	f.ContainsID3v2 = len(v2.AllFrames()) > 0
+
+	return nil
+}
+
+// Close closes the underlying ID3v2 tag's file.
+func (f *File) Close() error {
+	return f.ID3v2Tag.Close()
+}
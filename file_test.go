@@ -0,0 +1,151 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+// writeTestFile writes a file with an ID3v2.3 tag containing frames
+// (built from id/body pairs), some fake music data and, if withID3v1,
+// a trailing ID3v1 tag.
+func writeTestFile(t *testing.T, frames map[string][]byte, withID3v1 bool) string {
+	t.Helper()
+
+	path := writeTestTag(t, frames)
+
+	if withID3v1 {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		tag := make([]byte, 128)
+		copy(tag, "TAG")
+		copy(tag[3:33], "Old Title")
+		if _, err := f.Write(tag); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return path
+}
+
+func TestFileSaveSyncID3v1FromID3v2(t *testing.T) {
+	path := writeTestFile(t, map[string][]byte{
+		"TIT2": append([]byte{0}, "New Title"...),
+	}, true)
+
+	f, err := OpenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := f.Save(SaveOptions{SyncID3v1FromID3v2: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := OpenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+
+	if !reopened.ContainsID3v1 {
+		t.Fatal("ContainsID3v1 = false after Save, want true")
+	}
+	if got, want := reopened.ID3v1Tag.Title(), "New Title"; got != want {
+		t.Errorf("ID3v1Tag.Title() after sync = %q, want %q", got, want)
+	}
+}
+
+func TestFileSaveStripID3v1(t *testing.T) {
+	path := writeTestFile(t, map[string][]byte{
+		"TIT2": append([]byte{0}, "Title"...),
+	}, true)
+
+	f, err := OpenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := f.Save(SaveOptions{StripID3v1: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := OpenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+
+	if reopened.ContainsID3v1 {
+		t.Error("ContainsID3v1 = true after Save with StripID3v1, want false")
+	}
+}
+
+func TestFileSavePreservesMusicData(t *testing.T) {
+	path := writeTestFile(t, map[string][]byte{
+		"TIT2": append([]byte{0}, "Title"...),
+	}, false)
+
+	f, err := OpenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := f.Save(SaveOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !contains(raw, []byte("fake music data")) {
+		t.Error("Save dropped the music data that followed the original tag")
+	}
+}
+
+func TestFileSaveWithoutOptionsDoesNotAddID3v1(t *testing.T) {
+	path := writeTestFile(t, map[string][]byte{
+		"TIT2": append([]byte{0}, "Title"...),
+	}, false)
+
+	f, err := OpenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := f.Save(SaveOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := OpenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+
+	if reopened.ContainsID3v1 {
+		t.Error("ContainsID3v1 = true after plain Save on a file with no ID3v1 tag, want false")
+	}
+}
+
+func contains(haystack, needle []byte) bool {
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		if string(haystack[i:i+len(needle)]) == string(needle) {
+			return true
+		}
+	}
+	return false
+}
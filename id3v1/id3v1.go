@@ -0,0 +1,168 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package id3v1 provides a reader and writer for ID3v1 and ID3v1.1 tags,
+// the 128-byte fixed-layout tags stored at the very end of an MP3 file.
+package id3v1
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+const (
+	tagSize     = 128
+	titleSize   = 30
+	artistSize  = 30
+	albumSize   = 30
+	yearSize    = 4
+	commentSize = 30
+)
+
+// ErrNoTag is returned by Parse and ParseBytes if the given data doesn't
+// start with the "TAG" identifier.
+var ErrNoTag = errors.New("id3v1: no ID3v1 tag found")
+
+// Tag represents an ID3v1 or ID3v1.1 tag.
+type Tag struct {
+	title   string
+	artist  string
+	album   string
+	year    string
+	comment string
+	genre   string
+
+	// track is only written out when non-zero, per the ID3v1.1 convention
+	// of repurposing the last two comment bytes as a zero byte followed
+	// by the track number.
+	track int
+}
+
+// NewTag returns a new empty Tag.
+func NewTag() *Tag {
+	return &Tag{}
+}
+
+// Parse reads an ID3v1 tag from the last 128 bytes of r.
+func Parse(r io.ReadSeeker) (*Tag, error) {
+	if _, err := r.Seek(-tagSize, io.SeekEnd); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, tagSize)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	return ParseBytes(buf)
+}
+
+// ParseBytes parses a raw 128-byte ID3v1 tag.
+func ParseBytes(buf []byte) (*Tag, error) {
+	if len(buf) != tagSize || string(buf[0:3]) != "TAG" {
+		return nil, ErrNoTag
+	}
+
+	t := &Tag{
+		title:  trimField(buf[3:33]),
+		artist: trimField(buf[33:63]),
+		album:  trimField(buf[63:93]),
+		year:   trimField(buf[93:97]),
+	}
+
+	comment := buf[97:127]
+	if comment[28] == 0 && comment[29] != 0 {
+		t.comment = trimField(comment[:28])
+		t.track = int(comment[29])
+	} else {
+		t.comment = trimField(comment)
+	}
+
+	if genre, ok := GenreByIndex(buf[127]); ok {
+		t.genre = genre
+	}
+
+	return t, nil
+}
+
+// Bytes serializes t into a 128-byte ID3v1.1 tag, ready to be written
+// as the last 128 bytes of an MP3 file.
+func (t *Tag) Bytes() []byte {
+	buf := make([]byte, tagSize)
+	copy(buf[0:3], "TAG")
+	writeField(buf[3:33], t.title)
+	writeField(buf[33:63], t.artist)
+	writeField(buf[63:93], t.album)
+	writeField(buf[93:97], t.year)
+
+	if t.track > 0 {
+		writeField(buf[97:125], t.comment)
+		buf[125] = 0
+		buf[126] = byte(t.track)
+	} else {
+		writeField(buf[97:127], t.comment)
+	}
+
+	if idx, ok := IndexByGenre(t.genre); ok {
+		buf[127] = idx
+	} else {
+		buf[127] = 255 // "unknown" per the ID3v1 convention
+	}
+
+	return buf
+}
+
+func (t Tag) Title() string   { return t.title }
+func (t Tag) Artist() string  { return t.artist }
+func (t Tag) Album() string   { return t.album }
+func (t Tag) Year() string    { return t.year }
+func (t Tag) Comment() string { return t.comment }
+func (t Tag) Genre() string   { return t.genre }
+func (t Tag) Track() int      { return t.track }
+
+func (t *Tag) SetTitle(title string) { t.title = truncate(title, titleSize) }
+
+func (t *Tag) SetArtist(artist string) { t.artist = truncate(artist, artistSize) }
+
+func (t *Tag) SetAlbum(album string) { t.album = truncate(album, albumSize) }
+
+func (t *Tag) SetYear(year string) { t.year = truncate(year, yearSize) }
+
+// SetComment sets the comment field. Setting a track number via SetTrack
+// shortens the usable comment field to 28 bytes, as specified by ID3v1.1.
+func (t *Tag) SetComment(comment string) {
+	if t.track > 0 {
+		t.comment = truncate(comment, commentSize-2)
+	} else {
+		t.comment = truncate(comment, commentSize)
+	}
+}
+
+func (t *Tag) SetGenre(genre string) { t.genre = genre }
+
+// SetTrack sets the ID3v1.1 track number. Use 0 to omit it, which
+// restores the full 30-byte comment field.
+func (t *Tag) SetTrack(track int) {
+	t.track = track
+	if track > 0 && len(t.comment) > commentSize-2 {
+		t.comment = t.comment[:commentSize-2]
+	}
+}
+
+func trimField(b []byte) string {
+	return string(bytes.TrimRight(b, "\x00 "))
+}
+
+func writeField(dst []byte, s string) {
+	n := copy(dst, s)
+	for i := n; i < len(dst); i++ {
+		dst[i] = 0
+	}
+}
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
@@ -0,0 +1,97 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v1
+
+import "testing"
+
+func TestParseBytesRoundTrip(t *testing.T) {
+	tag := NewTag()
+	tag.SetTitle("Title")
+	tag.SetArtist("Artist")
+	tag.SetAlbum("Album")
+	tag.SetYear("2026")
+	tag.SetComment("Comment")
+	tag.SetGenre("Rock")
+
+	got, err := ParseBytes(tag.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Title() != "Title" {
+		t.Errorf("Title() = %q, want %q", got.Title(), "Title")
+	}
+	if got.Artist() != "Artist" {
+		t.Errorf("Artist() = %q, want %q", got.Artist(), "Artist")
+	}
+	if got.Album() != "Album" {
+		t.Errorf("Album() = %q, want %q", got.Album(), "Album")
+	}
+	if got.Year() != "2026" {
+		t.Errorf("Year() = %q, want %q", got.Year(), "2026")
+	}
+	if got.Comment() != "Comment" {
+		t.Errorf("Comment() = %q, want %q", got.Comment(), "Comment")
+	}
+	if got.Genre() != "Rock" {
+		t.Errorf("Genre() = %q, want %q", got.Genre(), "Rock")
+	}
+	if got.Track() != 0 {
+		t.Errorf("Track() = %d, want 0", got.Track())
+	}
+}
+
+func TestParseBytesInvalid(t *testing.T) {
+	if _, err := ParseBytes([]byte("not a tag")); err != ErrNoTag {
+		t.Errorf("ParseBytes with bad data: got err %v, want ErrNoTag", err)
+	}
+}
+
+func TestSetTrackPacksIntoComment(t *testing.T) {
+	tag := NewTag()
+	tag.SetComment("A long comment that fills the whole field up")
+	tag.SetTrack(7)
+
+	got, err := ParseBytes(tag.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Track() != 7 {
+		t.Errorf("Track() = %d, want 7", got.Track())
+	}
+	if len(got.Comment()) > commentSize-2 {
+		t.Errorf("Comment() = %q, longer than the %d bytes left once a track is set", got.Comment(), commentSize-2)
+	}
+}
+
+func TestGenreByIndexAndIndexByGenre(t *testing.T) {
+	genre, ok := GenreByIndex(0)
+	if !ok || genre != "Blues" {
+		t.Errorf("GenreByIndex(0) = %q, %v, want %q, true", genre, ok, "Blues")
+	}
+
+	idx, ok := IndexByGenre("Blues")
+	if !ok || idx != 0 {
+		t.Errorf("IndexByGenre(%q) = %d, %v, want 0, true", "Blues", idx, ok)
+	}
+
+	if _, ok := GenreByIndex(255); ok {
+		t.Error("GenreByIndex(255) = ok, want false")
+	}
+	if _, ok := IndexByGenre("Not a real genre"); ok {
+		t.Error(`IndexByGenre("Not a real genre") = ok, want false`)
+	}
+}
+
+func TestBytesUnknownGenre(t *testing.T) {
+	tag := NewTag()
+	tag.SetGenre("Not a real genre")
+
+	buf := tag.Bytes()
+	if buf[127] != 255 {
+		t.Errorf("genre byte = %d, want 255 for an unknown genre", buf[127])
+	}
+}
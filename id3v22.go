@@ -0,0 +1,101 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+
+	"github.com/bogem/id3v2/util"
+)
+
+// ID3v2 version numbers, as stored in the third byte of the tag header.
+const (
+	V22 byte = 2
+	V23 byte = 3
+	V24 byte = 4
+)
+
+// v22ToV23Frames maps ID3v2.2's three-character frame identifiers to
+// their ID3v2.3/ID3v2.4 four-character equivalents, covering the frames
+// commonly found in the wild. A frame read from a v2.2 tag is stored
+// under its v2.3 ID, so Tag.ID and GetFrames stay uniform across tag
+// versions.
+var v22ToV23Frames = map[string]string{
+	"BUF": "RBUF", "CNT": "PCNT", "COM": "COMM", "CRA": "AENC",
+	"ETC": "ETCO", "GEO": "GEOB", "IPL": "IPLS", "MCI": "MCDI",
+	"MLL": "MLLT", "PIC": "APIC", "POP": "POPM", "REV": "RVRB",
+	"SLT": "SYLT", "STC": "SYTC", "TAL": "TALB", "TBP": "TBPM",
+	"TCM": "TCOM", "TCO": "TCON", "TCR": "TCOP", "TDA": "TDAT",
+	"TDY": "TDLY", "TEN": "TENC", "TFT": "TFLT", "TIM": "TIME",
+	"TKE": "TKEY", "TLA": "TLAN", "TLE": "TLEN", "TMT": "TMED",
+	"TOA": "TOPE", "TOF": "TOFN", "TOL": "TOLY", "TOR": "TORY",
+	"TOT": "TOAL", "TP1": "TPE1", "TP2": "TPE2", "TP3": "TPE3",
+	"TP4": "TPE4", "TPA": "TPOS", "TPB": "TPUB", "TRC": "TSRC",
+	"TRD": "TRDA", "TRK": "TRCK", "TSI": "TSIZ", "TSS": "TSSE",
+	"TT1": "TIT1", "TT2": "TIT2", "TT3": "TIT3", "TXT": "TEXT",
+	"TXX": "TXXX", "TYE": "TYER", "UFI": "UFID", "ULT": "USLT",
+	"WAF": "WOAF", "WAR": "WOAR", "WAS": "WOAS", "WCM": "WCOM",
+	"WCP": "WCOP", "WPB": "WPUB", "WXX": "WXXX",
+}
+
+// v23ToV22Frames is the reverse of v22ToV23Frames, used to downgrade
+// frame IDs when writing a Tag whose Version is still V22.
+var v23ToV22Frames = reverseFrameIDs(v22ToV23Frames)
+
+func reverseFrameIDs(m map[string]string) map[string]string {
+	r := make(map[string]string, len(m))
+	for v22ID, v23ID := range m {
+		r[v23ID] = v22ID
+	}
+	return r
+}
+
+// Version returns the ID3v2 version (V22, V23 or V24) the tag was
+// parsed as, or V23 if it wasn't parsed from an existing tag.
+func (t Tag) Version() byte {
+	if t.version == 0 {
+		return V23
+	}
+	return t.version
+}
+
+// UpgradeVersion changes the version the tag will be saved as.
+//
+// It's most useful for upgrading a parsed ID3v2.2 tag: v2.2's
+// three-character frame IDs and 6-byte frame headers are a legacy
+// format that few modern tools support. Calling UpgradeVersion(V23)
+// makes the next Save write ID3v2.3 frame IDs and frame headers instead
+// of re-emitting the v2.2 layout.
+func (t *Tag) UpgradeVersion(newVersion byte) {
+	t.version = newVersion
+}
+
+// writeFrameHeader writes a frame header for id and frameSize, using
+// the 6-byte ID3v2.2 format (3-byte ID, plain 3-byte size, no flags) if
+// the tag is still at V22, or the standard 10-byte v2.3/v2.4 format
+// otherwise. The 10-byte format's size field is synchsafe for V24 but a
+// plain 32-bit integer for V23, same as v2.2's 3-byte size.
+func (t Tag) writeFrameHeader(buf *bytes.Buffer, id string, frameSize int64) {
+	switch t.Version() {
+	case V22:
+		v22ID := id
+		if downgraded, ok := v23ToV22Frames[id]; ok {
+			v22ID = downgraded
+		}
+		buf.WriteString(v22ID)
+		buf.Write(util.FormSize24(frameSize))
+		return
+
+	case V24:
+		buf.WriteString(id)
+		buf.Write(util.FormSize(frameSize))
+
+	default: // V23: frame size is a plain 32-bit integer, not synchsafe.
+		buf.WriteString(id)
+		buf.Write(util.FormSize32(frameSize))
+	}
+
+	buf.Write([]byte{0, 0})
+}
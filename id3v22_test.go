@@ -0,0 +1,51 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteFrameHeaderV22UsesThreeByteIDAndSize(t *testing.T) {
+	tag := &Tag{version: V22}
+
+	var buf bytes.Buffer
+	tag.writeFrameHeader(&buf, "APIC", 5)
+
+	want := []byte{'P', 'I', 'C', 0, 0, 5}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("writeFrameHeader(APIC, 5) at V22 = % x, want % x", buf.Bytes(), want)
+	}
+}
+
+func TestWriteFrameHeaderV23UsesPlain32BitSize(t *testing.T) {
+	tag := &Tag{version: V23}
+
+	var buf bytes.Buffer
+	// 0x81 has its high bit set, which a synchsafe size must reject but
+	// a plain ID3v2.3 frame size must preserve.
+	tag.writeFrameHeader(&buf, "APIC", 0x81)
+
+	want := []byte{'A', 'P', 'I', 'C', 0, 0, 0, 0x81, 0, 0}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("writeFrameHeader(APIC, 0x81) at V23 = % x, want % x", buf.Bytes(), want)
+	}
+}
+
+func TestVersionDefaultsToV23(t *testing.T) {
+	tag := &Tag{}
+	if v := tag.Version(); v != V23 {
+		t.Errorf("Version() on zero-value Tag = %d, want V23 (%d)", v, V23)
+	}
+}
+
+func TestUpgradeVersion(t *testing.T) {
+	tag := &Tag{version: V22}
+	tag.UpgradeVersion(V23)
+	if v := tag.Version(); v != V23 {
+		t.Errorf("Version() after UpgradeVersion(V23) = %d, want V23 (%d)", v, V23)
+	}
+}
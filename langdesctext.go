@@ -0,0 +1,118 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import "github.com/bogem/id3v2/util"
+
+// frameEncodingToUtil and utilEncodingToFrame translate between this
+// package's exported encoding constants (ENISO, ENUTF16, ...) and the
+// util package's, which CommentFrame/UnsynchronisedLyricsFrame don't
+// otherwise depend on.
+func frameEncodingToUtil(encoding byte) byte {
+	switch encoding {
+	case ENUTF16:
+		return util.EncodingUTF16
+	case ENUTF16BE:
+		return util.EncodingUTF16BE
+	case ENUTF8:
+		return util.EncodingUTF8
+	default:
+		return util.EncodingISO
+	}
+}
+
+func utilEncodingToFrame(encoding byte) byte {
+	switch encoding {
+	case util.EncodingUTF16:
+		return ENUTF16
+	case util.EncodingUTF16BE:
+		return ENUTF16BE
+	case util.EncodingUTF8:
+		return ENUTF8
+	default:
+		return ENISO
+	}
+}
+
+// parseLangDescText decodes the body of a COMM or USLT frame: an
+// encoding byte, a 3-byte ISO-639-2 language code, a short description
+// terminated by a null (two null bytes for the UTF-16 encodings, one
+// otherwise), then the remaining text. It returns ok=false if body is
+// too short to hold the encoding byte and language code.
+func parseLangDescText(body []byte) (encoding byte, language, description, text string, ok bool) {
+	if len(body) < 4 {
+		return 0, "", "", "", false
+	}
+
+	rawEncoding := body[0]
+	language = string(body[1:4])
+	rest := body[4:]
+
+	sepLen := 1
+	if rawEncoding == util.EncodingUTF16 || rawEncoding == util.EncodingUTF16BE {
+		sepLen = 2
+	}
+
+	descBytes, textBytes := rest, []byte(nil)
+	if i := indexNullSeparator(rest, sepLen); i >= 0 {
+		descBytes, textBytes = rest[:i], rest[i+sepLen:]
+	}
+
+	var err error
+	if description, err = util.DecodeText(rawEncoding, descBytes); err != nil {
+		return 0, "", "", "", false
+	}
+	if text, err = util.DecodeText(rawEncoding, textBytes); err != nil {
+		return 0, "", "", "", false
+	}
+
+	return utilEncodingToFrame(rawEncoding), language, description, text, true
+}
+
+// indexNullSeparator returns the offset of the first sepLen-aligned run
+// of sepLen zero bytes in b, or -1 if there is none.
+func indexNullSeparator(b []byte, sepLen int) int {
+	for i := 0; i+sepLen <= len(b); i += sepLen {
+		zero := true
+		for j := 0; j < sepLen; j++ {
+			if b[i+j] != 0 {
+				zero = false
+				break
+			}
+		}
+		if zero {
+			return i
+		}
+	}
+	return -1
+}
+
+// formLangDescText encodes language, description and text into the body
+// of a COMM or USLT frame in the given encoding, the mirror of
+// parseLangDescText.
+func formLangDescText(encoding byte, language, description, text string) []byte {
+	rawEncoding := frameEncodingToUtil(encoding)
+
+	lang := []byte(language)
+	switch {
+	case len(lang) > 3:
+		lang = lang[:3]
+	case len(lang) < 3:
+		padded := make([]byte, 3)
+		copy(padded, lang)
+		lang = padded
+	}
+
+	sep := []byte{0}
+	if rawEncoding == util.EncodingUTF16 || rawEncoding == util.EncodingUTF16BE {
+		sep = []byte{0, 0}
+	}
+
+	body := append([]byte{rawEncoding}, lang...)
+	body = append(body, util.EncodeText(rawEncoding, description)...)
+	body = append(body, sep...)
+	body = append(body, util.EncodeText(rawEncoding, text)...)
+	return body
+}
@@ -0,0 +1,47 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"io"
+	"os"
+)
+
+// Open opens the file at path and parses its ID3v2 tag, if any, for
+// reading and modifying frames. The returned Tag must be closed with
+// Close when the caller is done with it.
+func Open(path string) (*Tag, error) {
+	file, err := os.OpenFile(path, os.O_RDWR, 0666)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := parseTag(file, ParseOptions{})
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	t.file = file
+	t.reader = file
+	return t, nil
+}
+
+// ParseReader parses an ID3v2 tag from r and returns a Tag usable for
+// reading and modifying frames.
+//
+// Unlike Open, ParseReader doesn't require a real file on disk, so it
+// works with any io.ReadSeeker: an embedded resource, a bytes.Reader, or
+// an HTTP range body. A Tag returned by ParseReader has no backing
+// *os.File, so Save returns ErrNoFile; use WriteTo to emit the tagged
+// output instead.
+func ParseReader(r io.ReadSeeker) (*Tag, error) {
+	t, err := parseTag(r, ParseOptions{})
+	if err != nil {
+		return nil, err
+	}
+	t.reader = r
+	return t, nil
+}
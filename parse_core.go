@@ -0,0 +1,209 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"errors"
+	"io"
+
+	"github.com/bogem/id3v2/util"
+)
+
+// ErrInvalidHeader is returned by parseTag (and thus Open, ParseReader
+// and OpenReadOnly) when the stream starts with the "ID3" identifier but
+// the frames size in the header is malformed.
+var ErrInvalidHeader = errors.New("id3v2: invalid ID3v2 header")
+
+// standardFrameIDs maps the small set of frame descriptions this
+// package's Tag getters/setters and AddFrame dispatch rely on to their
+// ID3v2.3/v2.4 frame IDs. "Recording time" differs between versions.
+func standardFrameIDs(version byte) map[string]string {
+	year := "TYER"
+	if version == V24 {
+		year = "TDRC"
+	}
+	return map[string]string{
+		"Title/Songname/Content description":                  "TIT2",
+		"Lead artist/Lead performer/Soloist/Performing group": "TPE1",
+		"Album/Movie/Show title":                              "TALB",
+		"Recording time":                                      year,
+		"Content type":                                        "TCON",
+		"Attached picture":                                    "APIC",
+		"Comments":                                            "COMM",
+		"Unsynchronised lyrics/text transcription":            "USLT",
+	}
+}
+
+func newEmptyTag(version byte) *Tag {
+	return &Tag{
+		framesCoords: make(map[string][]frameCoordinates),
+		frames:       make(map[string]Framer),
+		sequences:    make(map[string]sequencer),
+		ids:          standardFrameIDs(version),
+		version:      version,
+	}
+}
+
+// tagHeaderSize is the fixed size in bytes of an ID3v2 header.
+const tagHeaderSize = 10
+
+// parseTag parses the ID3v2 header and frames of r according to opts.
+//
+// It detects the header's version byte and reads frames with either
+// the 6-byte ID3v2.2 frame header (3-byte ID, plain 3-byte size) or the
+// standard 10-byte v2.3/v2.4 frame header, translating v2.2 frame IDs
+// to their v2.3 equivalents so callers can keep using 4-character IDs
+// uniformly. Despite sharing the same 10-byte layout, only the v2.4
+// frame size is synchsafe; the v2.3 frame size is a plain 32-bit
+// integer, same as its v2.2 predecessor.
+//
+// If opts.Only is non-empty, frames whose (possibly translated) ID
+// isn't in it are skipped by seeking past their declared size rather
+// than being read and decoded, which is what makes OpenReadOnly cheap
+// for bulk library scans.
+func parseTag(r io.ReadSeeker, opts ParseOptions) (*Tag, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, tagHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return newEmptyTag(V23), nil
+		}
+		return nil, err
+	}
+
+	if string(header[0:3]) != "ID3" {
+		return newEmptyTag(V23), nil
+	}
+
+	version := header[3]
+	t := newEmptyTag(version)
+
+	framesSize, err := util.ParseSize(header[6:10])
+	if err != nil {
+		return nil, ErrInvalidHeader
+	}
+	t.originalSize = tagHeaderSize + framesSize
+
+	only := make(map[string]bool, len(opts.Only))
+	for _, id := range opts.Only {
+		only[id] = true
+	}
+
+	idSize, frameHeaderSize := 4, 10
+	if version == V22 {
+		idSize, frameHeaderSize = 3, 6
+	}
+
+	var read int64
+	for read+int64(frameHeaderSize) <= framesSize {
+		rawHeader := make([]byte, frameHeaderSize)
+		if _, err := io.ReadFull(r, rawHeader); err != nil {
+			return nil, err
+		}
+		read += int64(frameHeaderSize)
+
+		if rawHeader[0] == 0 {
+			break // reached padding
+		}
+
+		id := string(rawHeader[:idSize])
+
+		var bodySize int64
+		switch version {
+		case V22:
+			bodySize = util.ParseSize24(rawHeader[idSize:])
+		case V24:
+			bodySize, err = util.ParseSize(rawHeader[idSize : idSize+4])
+			if err != nil {
+				return nil, ErrInvalidHeader
+			}
+		default: // V23: frame sizes are a plain 32-bit integer, not synchsafe.
+			bodySize = util.ParseSize32(rawHeader[idSize : idSize+4])
+		}
+
+		if translated, ok := v22ToV23Frames[id]; version == V22 && ok {
+			id = translated
+		}
+
+		if len(only) > 0 && !only[id] {
+			if _, err := r.Seek(bodySize, io.SeekCurrent); err != nil {
+				return nil, err
+			}
+			read += bodySize
+			continue
+		}
+
+		body := make([]byte, bodySize)
+		if _, err := io.ReadFull(r, body); err != nil {
+			return nil, err
+		}
+		read += bodySize
+
+		t.AddFrame(id, parseFrameBody(id, body))
+	}
+
+	return t, nil
+}
+
+// isTextFrameID reports whether id is one of the plain text frames
+// (IDs beginning with "T", other than the free-form TXXX) whose body is
+// just an encoding byte followed by text.
+func isTextFrameID(id string) bool {
+	return len(id) > 0 && id[0] == 'T' && id != "TXXX"
+}
+
+// parseFrameBody decodes the body of a single frame into a Framer.
+//
+// Plain text frames are decoded through util.DecodeText so their Text
+// is already clean of BOMs, terminating nulls and encoding noise. COMM
+// and USLT carry a language code and description ahead of their text,
+// so they're decoded into CommentFrame/UnsynchronisedLyricsFrame
+// instead. Every other frame is kept as its raw, undecoded body so it
+// round-trips unchanged even though this package doesn't understand its
+// internal layout.
+func parseFrameBody(id string, body []byte) Framer {
+	switch id {
+	case "COMM":
+		if encoding, language, description, text, ok := parseLangDescText(body); ok {
+			return CommentFrame{Encoding: encoding, Language: language, Description: description, Text: text}
+		}
+
+	case "USLT":
+		if encoding, language, description, text, ok := parseLangDescText(body); ok {
+			return UnsynchronisedLyricsFrame{Encoding: encoding, Language: language, Description: description, Text: text}
+		}
+
+	default:
+		if isTextFrameID(id) && len(body) > 0 {
+			if text, err := util.DecodeText(body[0], body[1:]); err == nil {
+				switch body[0] {
+				case util.EncodingISO:
+					return TextFrame{Encoding: ENISO, Text: text}
+				case util.EncodingUTF16:
+					return TextFrame{Encoding: ENUTF16, Text: text}
+				case util.EncodingUTF16BE:
+					return TextFrame{Encoding: ENUTF16BE, Text: text}
+				case util.EncodingUTF8:
+					return TextFrame{Encoding: ENUTF8, Text: text}
+				}
+			}
+		}
+	}
+
+	return rawFrame{body}
+}
+
+// rawFrame is a Framer that carries a frame's body exactly as read,
+// used for any frame type this package doesn't decode further.
+type rawFrame struct {
+	body []byte
+}
+
+func (f rawFrame) Body() []byte {
+	return f.body
+}
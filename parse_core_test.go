@@ -0,0 +1,85 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestParseTagV23LargeFrameBody guards against treating ID3v2.3 frame
+// sizes as synchsafe: a body of 201 bytes (an encoding byte plus 200
+// 'x') has 201 (0xC9) in the low byte of its plain 32-bit size, which a
+// synchsafe decode would reject.
+func TestParseTagV23LargeFrameBody(t *testing.T) {
+	body := append([]byte{0}, strings.Repeat("x", 200)...)
+	path := writeTestTag(t, map[string][]byte{"APIC": body})
+
+	tag, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tag.Close()
+
+	f := tag.GetLastFrame("APIC")
+	if f == nil {
+		t.Fatal("GetLastFrame(APIC) = nil, want the 201-byte frame written")
+	}
+	if !bytes.Equal(f.Body(), body) {
+		t.Errorf("APIC frame body = %d bytes, want %d bytes matching the original", len(f.Body()), len(body))
+	}
+}
+
+func TestParseFrameBodyCommentFrame(t *testing.T) {
+	body := append([]byte{ENUTF8}, "eng"...)
+	body = append(body, "short desc"...)
+	body = append(body, 0)
+	body = append(body, "the actual comment"...)
+
+	f := parseFrameBody("COMM", body)
+	cf, ok := f.(CommentFrame)
+	if !ok {
+		t.Fatalf("parseFrameBody(COMM, ...) = %T, want CommentFrame", f)
+	}
+
+	if cf.Language != "eng" {
+		t.Errorf("Language = %q, want %q", cf.Language, "eng")
+	}
+	if cf.Description != "short desc" {
+		t.Errorf("Description = %q, want %q", cf.Description, "short desc")
+	}
+	if cf.Text != "the actual comment" {
+		t.Errorf("Text = %q, want %q", cf.Text, "the actual comment")
+	}
+}
+
+func TestCommentFrameBodyRoundTrip(t *testing.T) {
+	want := CommentFrame{
+		Encoding:    ENUTF8,
+		Language:    "eng",
+		Description: "short desc",
+		Text:        "the actual comment",
+	}
+
+	got := parseFrameBody("COMM", want.Body())
+	if got != want {
+		t.Errorf("round-trip through Body()/parseFrameBody = %+v, want %+v", got, want)
+	}
+}
+
+func TestUnsynchronisedLyricsFrameBodyRoundTrip(t *testing.T) {
+	want := UnsynchronisedLyricsFrame{
+		Encoding:    ENUTF8,
+		Language:    "eng",
+		Description: "",
+		Text:        "la la la",
+	}
+
+	got := parseFrameBody("USLT", want.Body())
+	if got != want {
+		t.Errorf("round-trip through Body()/parseFrameBody = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"errors"
+	"os"
+)
+
+// ErrReadOnly is returned by Save on a Tag opened with OpenReadOnly,
+// which closes its underlying file as soon as it has read the frames it
+// was asked for.
+var ErrReadOnly = errors.New("id3v2: tag is read-only")
+
+// ParseOptions configures how OpenReadOnly parses a tag. Open and
+// ParseReader take no options: they always parse every frame.
+type ParseOptions struct {
+	// ReadOnly opens the file O_RDONLY and closes it as soon as the
+	// requested frames have been read into memory, making the returned
+	// Tag's Save always return ErrReadOnly. OpenReadOnly sets this
+	// automatically.
+	ReadOnly bool
+
+	// Only restricts parsing to these frame IDs (e.g. "TIT2", "TPE1").
+	// Frames outside this set are skipped using their declared size
+	// instead of being decoded, which is significantly faster when a
+	// scan only needs a handful of frames from each file. An empty
+	// Only parses every frame.
+	Only []string
+}
+
+// OpenReadOnly opens the file at path for reading only. It reads the
+// tag header and, per opts.Only, either every frame or only the named
+// ones into memory, then closes the file immediately. This is much
+// cheaper than Open for bulk library scans that read a handful of
+// frames (e.g. Title/Artist/Album) from many files and never save them.
+//
+// The returned Tag's Save always returns ErrReadOnly.
+func OpenReadOnly(path string, opts ParseOptions) (*Tag, error) {
+	opts.ReadOnly = true
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	t, err := parseTag(file, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	t.readOnly = true
+	t.file = nil
+	t.reader = nil
+
+	return t, nil
+}
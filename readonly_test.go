@@ -0,0 +1,93 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/bogem/id3v2/util"
+)
+
+// writeTestTag writes a minimal ID3v2.3 tag containing frames (built
+// from id/body pairs) followed by some fake music bytes to a new
+// temporary file and returns its path.
+func writeTestTag(t *testing.T, frames map[string][]byte) string {
+	t.Helper()
+
+	var body []byte
+	for id, frame := range frames {
+		body = append(body, id...)
+		// ID3v2.3 frame sizes are a plain 32-bit integer, not synchsafe.
+		body = append(body, util.FormSize32(int64(len(frame)))...)
+		body = append(body, 0, 0)
+		body = append(body, frame...)
+	}
+
+	f, err := ioutil.TempFile("", "id3v2test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Remove(f.Name()) })
+	defer f.Close()
+
+	f.WriteString("ID3")
+	f.Write([]byte{3, 0, 0})
+	f.Write(util.FormSize(int64(len(body))))
+	f.Write(body)
+	f.Write([]byte("fake music data"))
+
+	return f.Name()
+}
+
+func TestOpenReadOnlyOnlySkipsUnwantedFrames(t *testing.T) {
+	path := writeTestTag(t, map[string][]byte{
+		"TIT2": append([]byte{0}, "Title"...),
+		"TPE1": append([]byte{0}, "Artist"...),
+	})
+
+	tag, err := OpenReadOnly(path, ParseOptions{Only: []string{"TIT2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fs := tag.GetFrames("TIT2"); len(fs) != 1 {
+		t.Errorf("GetFrames(TIT2) = %v, want 1 requested frame", fs)
+	}
+	if fs := tag.GetFrames("TPE1"); len(fs) != 0 {
+		t.Errorf("GetFrames(TPE1) = %v, want 0 frames (skipped by Only)", fs)
+	}
+}
+
+func TestOpenReadOnlySaveReturnsErrReadOnly(t *testing.T) {
+	path := writeTestTag(t, map[string][]byte{
+		"TIT2": append([]byte{0}, "Title"...),
+	})
+
+	tag, err := OpenReadOnly(path, ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tag.Save(); err != ErrReadOnly {
+		t.Errorf("Save() = %v, want ErrReadOnly", err)
+	}
+}
+
+func TestOpenReadOnlyWriteToReturnsErrReadOnly(t *testing.T) {
+	path := writeTestTag(t, map[string][]byte{
+		"TIT2": append([]byte{0}, "Title"...),
+	})
+
+	tag, err := OpenReadOnly(path, ParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tag.WriteTo(ioutil.Discard); err != ErrReadOnly {
+		t.Errorf("WriteTo() = %v, want ErrReadOnly", err)
+	}
+}
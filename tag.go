@@ -5,7 +5,7 @@
 package id3v2
 
 import (
-	"bytes"
+	"errors"
 	"io"
 	"io/ioutil"
 	"os"
@@ -14,6 +14,11 @@ import (
 	"github.com/bogem/id3v2/util"
 )
 
+// ErrNoFile is returned by Save if the tag wasn't opened from a regular
+// file (e.g. it was constructed with ParseReader), so there's no file
+// on disk to rewrite. Use WriteTo instead.
+var ErrNoFile = errors.New("id3v2: tag wasn't opened from a file, use WriteTo instead")
+
 // Tag stores all frames of opened file.
 type Tag struct {
 	framesCoords map[string][]frameCoordinates
@@ -21,8 +26,22 @@ type Tag struct {
 	sequences    map[string]sequencer
 	ids          map[string]string
 
+	reader       io.ReadSeeker
 	file         *os.File
 	originalSize int64
+	padding      int
+	readOnly     bool
+	version      byte
+}
+
+// SetPadding sets the number of zero bytes Save reserves after the
+// frames whenever it has to rewrite the whole file (i.e. the new frames
+// plus this padding no longer fit in the space of the original tag).
+// A larger padding
+// makes a subsequent Save more likely to be able to update the file in
+// place instead of copying the whole music part again.
+func (t *Tag) SetPadding(n int) {
+	t.padding = n
 }
 
 func (t *Tag) AddFrame(id string, f Framer) {
@@ -209,62 +228,173 @@ func (t *Tag) SetGenre(genre string) {
 }
 
 // Save writes tag to the file.
+//
+// If the new frames plus the tag's padding still fit within the space
+// occupied by the original tag, Save rewrites just the header, frames
+// and zero-padding in place, leaving the (possibly large) music part of
+// the file untouched. Otherwise it falls back to copying the whole file
+// through a temp file, reserving t.padding bytes of fresh padding so a
+// later Save is more likely to take the in-place path.
 func (t *Tag) Save() error {
-	// Forming new frames
+	if t.readOnly {
+		return ErrReadOnly
+	}
+	if t.file == nil {
+		return ErrNoFile
+	}
+
 	frames := t.formAllFrames()
+	framesSize := int64(len(frames))
 
-	// Forming size of new frames
-	framesSize := util.FormSize(int64(len(frames)))
+	if framesSize+int64(t.padding) <= t.originalSize-tagHeaderSize {
+		return t.saveInPlace(frames)
+	}
+	return t.saveToTempFile(frames)
+}
 
-	// Creating a temp file for mp3 file, which will contain new tag
-	newFile, err := ioutil.TempFile("", "")
+// saveInPlace overwrites the header, frames and zero-padding of an
+// already-open file without touching the music part that follows them.
+func (t *Tag) saveInPlace(frames []byte) error {
+	padding := t.originalSize - tagHeaderSize - int64(len(frames))
+
+	if _, err := t.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := t.writeTagTo(t.file, frames, padding); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// saveToTempFile rewrites the whole file through a temp file, reserving
+// t.padding bytes of padding after the new frames, then renames the
+// temp file over the original.
+func (t *Tag) saveToTempFile(frames []byte) error {
+	stat, err := t.file.Stat()
 	if err != nil {
 		return err
 	}
+	musicSize := stat.Size() - t.originalSize
+
+	return t.rewriteThroughTempFile(frames, int64(t.padding), musicSize, nil)
+}
 
-	// Writing to new file new tag header
-	if _, err = newFile.Write(formTagHeader(framesSize)); err != nil {
+// rewriteThroughTempFile rewrites the tag's file through a temp file
+// that is renamed over the original in a single step: the new header
+// and frames (with padding reserved after them), then musicSize bytes
+// of the original file's music part, then trailer verbatim. File.Save
+// uses trailer to append a rewritten ID3v1 tag in the same atomic
+// rename, so the two tags never fall out of sync.
+func (t *Tag) rewriteThroughTempFile(frames []byte, padding, musicSize int64, trailer []byte) error {
+	newFile, err := ioutil.TempFile("", "")
+	if err != nil {
 		return err
 	}
 
-	// Writing to new file new frames
-	if _, err = newFile.Write(frames); err != nil {
+	newOriginalSize, err := t.writeTagTo(newFile, frames, padding)
+	if err != nil {
 		return err
 	}
 
-	// Seeking to a music part of mp3
 	originalFile := t.file
 	defer originalFile.Close()
-	if _, err = originalFile.Seek(t.originalSize, os.SEEK_SET); err != nil {
+
+	if _, err := originalFile.Seek(t.originalSize, io.SeekStart); err != nil {
 		return err
 	}
-
-	// Writing to new file the music part
-	if _, err = io.Copy(newFile, originalFile); err != nil {
+	if _, err := io.CopyN(newFile, originalFile, musicSize); err != nil {
 		return err
 	}
 
-	// Getting original file mode
+	if len(trailer) > 0 {
+		if _, err := newFile.Write(trailer); err != nil {
+			return err
+		}
+	}
+
 	originalFileStat, err := originalFile.Stat()
 	if err != nil {
 		return err
 	}
-	originalFileMode := originalFileStat.Mode()
-
-	// Setting new file mode
-	if err = newFile.Chmod(originalFileMode); err != nil {
+	if err := newFile.Chmod(originalFileStat.Mode()); err != nil {
 		return err
 	}
 
-	// Replacing original file with new file
-	if err = os.Rename(newFile.Name(), originalFile.Name()); err != nil {
+	if err := os.Rename(newFile.Name(), originalFile.Name()); err != nil {
 		return err
 	}
 	t.file = newFile
+	t.reader = newFile
+	t.originalSize = newOriginalSize
 
 	return nil
 }
 
+// writeTagTo writes the header, frames and padding zero bytes to w and
+// returns the resulting size of the tag (tagHeaderSize + len(frames) +
+// padding), which is kept in sync with the synchsafe size field in the
+// header.
+func (t *Tag) writeTagTo(w io.Writer, frames []byte, padding int64) (int64, error) {
+	framesSize := int64(len(frames))
+
+	if _, err := w.Write(formTagHeader(util.FormSize(framesSize + padding))); err != nil {
+		return 0, err
+	}
+	if _, err := w.Write(frames); err != nil {
+		return 0, err
+	}
+	if padding > 0 {
+		if _, err := io.CopyN(w, zeroReader{}, padding); err != nil {
+			return 0, err
+		}
+	}
+
+	return tagHeaderSize + framesSize + padding, nil
+}
+
+// zeroReader is an io.Reader that produces an endless stream of zero
+// bytes, used to write padding without allocating a padding-sized slice.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+// WriteTo writes the tag - header, frames and the music part - to w and
+// returns the number of bytes written. It satisfies io.WriterTo.
+//
+// WriteTo writes no padding and only reads from the tag's underlying
+// reader, so it works whether the tag was constructed with Open or
+// ParseReader. A Tag opened with OpenReadOnly has already closed its
+// reader, so WriteTo returns ErrReadOnly instead.
+func (t *Tag) WriteTo(w io.Writer) (n int64, err error) {
+	if t.readOnly {
+		return 0, ErrReadOnly
+	}
+
+	frames := t.formAllFrames()
+
+	written, err := t.writeTagTo(w, frames, 0)
+	n += written
+	if err != nil {
+		return n, err
+	}
+
+	// Seeking to the music part of the original stream
+	if _, err = t.reader.Seek(t.originalSize, io.SeekStart); err != nil {
+		return n, err
+	}
+
+	// Writing the music part
+	copied, err := io.Copy(w, t.reader)
+	n += copied
+	return n, err
+}
+
 // Close closes the tag's file, rendering it unusable for I/O.
 // It returns an error, if any.
 func (t *Tag) Close() error {
@@ -283,12 +413,12 @@ func (t Tag) formAllFrames() []byte {
 func (t Tag) writeFrames(w io.Writer) {
 	for id, frames := range t.AllFrames() {
 		for _, f := range frames {
-			w.Write(formFrame(id, f))
+			w.Write(t.formFrame(id, f))
 		}
 	}
 }
 
-func formFrame(id string, frame Framer) []byte {
+func (t Tag) formFrame(id string, frame Framer) []byte {
 	if id == "" {
 		panic("there is blank ID in frames")
 	}
@@ -297,14 +427,8 @@ func formFrame(id string, frame Framer) []byte {
 	defer bytesbufferpool.Put(frameBuffer)
 
 	frameBody := frame.Body()
-	writeFrameHeader(frameBuffer, id, int64(len(frameBody)))
+	t.writeFrameHeader(frameBuffer, id, int64(len(frameBody)))
 	frameBuffer.Write(frameBody)
 
 	return frameBuffer.Bytes()
 }
-
-func writeFrameHeader(buf *bytes.Buffer, id string, frameSize int64) {
-	buf.WriteString(id)
-	buf.Write(util.FormSize(frameSize))
-	buf.Write([]byte{0, 0})
-}
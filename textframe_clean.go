@@ -0,0 +1,20 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+import "strings"
+
+// CleanText returns tf.Text with non-printable ASCII control characters
+// stripped. Frames written by other taggers sometimes carry embedded
+// nulls or stray control bytes alongside otherwise valid text; callers
+// that display the value directly should use CleanText instead of Text.
+func (tf TextFrame) CleanText() string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7F {
+			return -1
+		}
+		return r
+	}, tf.Text)
+}
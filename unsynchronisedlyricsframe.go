@@ -0,0 +1,20 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package id3v2
+
+// UnsynchronisedLyricsFrame represents the ID3v2 USLT frame: lyrics or
+// other text transcription that isn't synchronised with the audio,
+// tagged with a 3-letter ISO-639-2 language code and an optional short
+// Description, followed by the actual Text.
+type UnsynchronisedLyricsFrame struct {
+	Encoding    byte
+	Language    string
+	Description string
+	Text        string
+}
+
+func (f UnsynchronisedLyricsFrame) Body() []byte {
+	return formLangDescText(f.Encoding, f.Language, f.Description, f.Text)
+}
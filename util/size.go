@@ -0,0 +1,40 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package util
+
+// FormSize24 encodes size as a plain (non-synchsafe) 3-byte big-endian
+// integer, the frame size format used by ID3v2.2 frame headers.
+func FormSize24(size int64) []byte {
+	return []byte{
+		byte(size >> 16),
+		byte(size >> 8),
+		byte(size),
+	}
+}
+
+// ParseSize24 decodes a plain 3-byte big-endian integer, the frame size
+// format used by ID3v2.2 frame headers.
+func ParseSize24(b []byte) int64 {
+	return int64(b[0])<<16 | int64(b[1])<<8 | int64(b[2])
+}
+
+// FormSize32 encodes size as a plain (non-synchsafe) 4-byte big-endian
+// integer, the frame size format used by ID3v2.3 frame headers. Unlike
+// the tag header and ID3v2.4 frame headers, ID3v2.3 frame sizes are NOT
+// synchsafe, so FormSize must not be used for them.
+func FormSize32(size int64) []byte {
+	return []byte{
+		byte(size >> 24),
+		byte(size >> 16),
+		byte(size >> 8),
+		byte(size),
+	}
+}
+
+// ParseSize32 decodes a plain 4-byte big-endian integer, the frame size
+// format used by ID3v2.3 frame headers.
+func ParseSize32(b []byte) int64 {
+	return int64(b[0])<<24 | int64(b[1])<<16 | int64(b[2])<<8 | int64(b[3])
+}
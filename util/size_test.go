@@ -0,0 +1,47 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package util
+
+import "testing"
+
+func TestFormSize24RoundTrip(t *testing.T) {
+	sizes := []int64{0, 1, 255, 256, 65535, 65536, 0xFFFFFF}
+
+	for _, size := range sizes {
+		got := ParseSize24(FormSize24(size))
+		if got != size {
+			t.Errorf("ParseSize24(FormSize24(%d)) = %d, want %d", size, got, size)
+		}
+	}
+}
+
+func TestFormSize24Is3Bytes(t *testing.T) {
+	if n := len(FormSize24(42)); n != 3 {
+		t.Errorf("len(FormSize24(42)) = %d, want 3", n)
+	}
+}
+
+func TestFormSize32RoundTrip(t *testing.T) {
+	sizes := []int64{0, 1, 127, 128, 255, 65536, 0x7FFFFFFF}
+
+	for _, size := range sizes {
+		got := ParseSize32(FormSize32(size))
+		if got != size {
+			t.Errorf("ParseSize32(FormSize32(%d)) = %d, want %d", size, got, size)
+		}
+	}
+}
+
+func TestFormSize32AllowsHighBit(t *testing.T) {
+	// Unlike the synchsafe ParseSize, ParseSize32 must accept a byte
+	// with its high bit set: ID3v2.3 frame sizes aren't synchsafe.
+	b := FormSize32(200)
+	if b[3] != 200 {
+		t.Fatalf("FormSize32(200)[3] = %d, want 200", b[3])
+	}
+	if got := ParseSize32(b); got != 200 {
+		t.Errorf("ParseSize32(% x) = %d, want 200", b, got)
+	}
+}
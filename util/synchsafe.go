@@ -0,0 +1,26 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package util
+
+import "errors"
+
+// ErrInvalidSizeByte is returned by ParseSize if one of the four bytes
+// has its most significant bit set, which a synchsafe integer must not.
+var ErrInvalidSizeByte = errors.New("util: invalid synchsafe size byte")
+
+// ParseSize decodes a synchsafe 4-byte big-endian integer (each byte
+// using only its low 7 bits), the size format used by the ID3v2 tag
+// header and by ID3v2.4 frame headers. ID3v2.3 frame sizes are plain
+// integers; use ParseSize32 for them.
+func ParseSize(b []byte) (int64, error) {
+	var size int64
+	for _, bb := range b {
+		if bb&0x80 != 0 {
+			return 0, ErrInvalidSizeByte
+		}
+		size = size<<7 | int64(bb)
+	}
+	return size, nil
+}
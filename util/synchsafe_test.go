@@ -0,0 +1,35 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package util
+
+import "testing"
+
+func TestParseSize(t *testing.T) {
+	cases := []struct {
+		in   []byte
+		want int64
+	}{
+		{[]byte{0x00, 0x00, 0x00, 0x00}, 0},
+		{[]byte{0x00, 0x00, 0x00, 0x7F}, 127},
+		{[]byte{0x00, 0x00, 0x01, 0x00}, 128},
+		{[]byte{0x00, 0x00, 0x02, 0x01}, 257},
+	}
+
+	for _, c := range cases {
+		got, err := ParseSize(c.in)
+		if err != nil {
+			t.Fatalf("ParseSize(% x) returned error: %v", c.in, err)
+		}
+		if got != c.want {
+			t.Errorf("ParseSize(% x) = %d, want %d", c.in, got, c.want)
+		}
+	}
+}
+
+func TestParseSizeInvalidByte(t *testing.T) {
+	if _, err := ParseSize([]byte{0x00, 0x00, 0x00, 0x80}); err != ErrInvalidSizeByte {
+		t.Errorf("ParseSize with MSB set: got err %v, want ErrInvalidSizeByte", err)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package util
+
+import (
+	"bytes"
+	"errors"
+	"unicode/utf16"
+)
+
+// ID3v2 text encodings, as stored in the first byte of a text-based
+// frame's body.
+const (
+	EncodingISO     byte = 0
+	EncodingUTF16   byte = 1
+	EncodingUTF16BE byte = 2
+	EncodingUTF8    byte = 3
+)
+
+// ErrUnknownEncoding is returned by DecodeText if encoding isn't one of
+// the four ID3v2 text encodings.
+var ErrUnknownEncoding = errors.New("util: unknown text encoding")
+
+var (
+	utf16LEBOM = []byte{0xFF, 0xFE}
+	utf16BEBOM = []byte{0xFE, 0xFF}
+)
+
+// DecodeText decodes raw, a text frame's body (or one field of it) with
+// the terminating null(s) still attached, according to encoding. It
+// strips the terminating null(s) and, for UTF-16, a leading BOM.
+func DecodeText(encoding byte, raw []byte) (string, error) {
+	switch encoding {
+	case EncodingISO:
+		return decodeISO88591(bytes.TrimRight(raw, "\x00")), nil
+
+	case EncodingUTF8:
+		return string(bytes.TrimRight(raw, "\x00")), nil
+
+	case EncodingUTF16, EncodingUTF16BE:
+		return decodeUTF16(encoding, raw)
+
+	default:
+		return "", ErrUnknownEncoding
+	}
+}
+
+// decodeISO88591 converts raw ISO-8859-1 bytes to a Go string. Every
+// byte of ISO-8859-1 maps directly to the Unicode code point of the
+// same value, so this isn't a no-op cast: bytes >= 0x80 are not valid
+// UTF-8 on their own and must be widened rune-by-rune instead.
+func decodeISO88591(raw []byte) string {
+	runes := make([]rune, len(raw))
+	for i, b := range raw {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
+
+func decodeUTF16(encoding byte, raw []byte) (string, error) {
+	bigEndian := encoding == EncodingUTF16BE
+
+	if len(raw) >= 2 {
+		switch {
+		case bytes.Equal(raw[:2], utf16LEBOM):
+			raw = raw[2:]
+			bigEndian = false
+		case bytes.Equal(raw[:2], utf16BEBOM):
+			raw = raw[2:]
+			bigEndian = true
+		}
+	}
+
+	// Drop a trailing two-byte null terminator and any odd trailing byte.
+	for len(raw) >= 2 && raw[len(raw)-2] == 0 && raw[len(raw)-1] == 0 {
+		raw = raw[:len(raw)-2]
+	}
+	if len(raw)%2 != 0 {
+		raw = raw[:len(raw)-1]
+	}
+
+	units := make([]uint16, len(raw)/2)
+	for i := range units {
+		if bigEndian {
+			units[i] = uint16(raw[2*i])<<8 | uint16(raw[2*i+1])
+		} else {
+			units[i] = uint16(raw[2*i+1])<<8 | uint16(raw[2*i])
+		}
+	}
+
+	return string(utf16.Decode(units)), nil
+}
+
+// EncodeText encodes s as the body of a text frame field in the given
+// ID3v2 text encoding, including a leading BOM for UTF-16 but excluding
+// any terminating null, which callers append themselves where the
+// format requires it.
+func EncodeText(encoding byte, s string) []byte {
+	switch encoding {
+	case EncodingISO:
+		return encodeISO88591(s)
+
+	case EncodingUTF16:
+		units := utf16.Encode([]rune(s))
+		buf := make([]byte, 0, 2+2*len(units))
+		buf = append(buf, utf16LEBOM...)
+		for _, u := range units {
+			buf = append(buf, byte(u), byte(u>>8))
+		}
+		return buf
+
+	case EncodingUTF16BE:
+		units := utf16.Encode([]rune(s))
+		buf := make([]byte, 0, 2*len(units))
+		for _, u := range units {
+			buf = append(buf, byte(u>>8), byte(u))
+		}
+		return buf
+
+	default: // EncodingUTF8
+		return []byte(s)
+	}
+}
+
+// encodeISO88591 converts s to ISO-8859-1 bytes, the mirror of
+// decodeISO88591. Runes outside the ISO-8859-1 range (0x00-0xFF) are
+// truncated to their low byte, same as the reference taggers this
+// package interoperates with.
+func encodeISO88591(s string) []byte {
+	runes := []rune(s)
+	b := make([]byte, len(runes))
+	for i, r := range runes {
+		b[i] = byte(r)
+	}
+	return b
+}
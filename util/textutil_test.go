@@ -0,0 +1,76 @@
+// Copyright 2016 Albert Nigmatzianov. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package util
+
+import "testing"
+
+func TestDecodeTextISO88591NonASCII(t *testing.T) {
+	// "café" in ISO-8859-1: c, a, f, 0xE9 ('é').
+	raw := []byte{'c', 'a', 'f', 0xE9, 0x00}
+
+	got, err := DecodeText(EncodingISO, raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "café"; got != want {
+		t.Errorf("DecodeText(EncodingISO, % x) = %q, want %q", raw, got, want)
+	}
+}
+
+func TestEncodeDecodeTextISO88591RoundTrip(t *testing.T) {
+	want := "café"
+
+	encoded := EncodeText(EncodingISO, want)
+	got, err := DecodeText(EncodingISO, encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("round-trip through EncodeText/DecodeText(EncodingISO) = %q, want %q", got, want)
+	}
+}
+
+func TestEncodeDecodeTextUTF16RoundTrip(t *testing.T) {
+	want := "hello, world"
+
+	encoded := EncodeText(EncodingUTF16, want)
+	got, err := DecodeText(EncodingUTF16, encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("round-trip through EncodeText/DecodeText(EncodingUTF16) = %q, want %q", got, want)
+	}
+}
+
+func TestEncodeDecodeTextUTF16BERoundTrip(t *testing.T) {
+	want := "hello, world"
+
+	encoded := EncodeText(EncodingUTF16BE, want)
+	got, err := DecodeText(EncodingUTF16BE, encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("round-trip through EncodeText/DecodeText(EncodingUTF16BE) = %q, want %q", got, want)
+	}
+}
+
+func TestDecodeTextStripsTerminatingNulls(t *testing.T) {
+	raw := []byte("hello\x00")
+	got, err := DecodeText(EncodingUTF8, raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "hello"; got != want {
+		t.Errorf("DecodeText(EncodingUTF8, %q) = %q, want %q", raw, got, want)
+	}
+}
+
+func TestDecodeTextUnknownEncoding(t *testing.T) {
+	if _, err := DecodeText(0xFF, []byte("x")); err != ErrUnknownEncoding {
+		t.Errorf("DecodeText with unknown encoding: got err %v, want ErrUnknownEncoding", err)
+	}
+}